@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SecretHeaderEnvName derives the env var name used to carry a secret-backed header value,
+// e.g. "api-key" becomes "OTEL_EXPORTER_OTLP_HEADERS_API_KEY".
+//
+// pkg/instrumentation calls it to name the injected env var. It lives in v1alpha1 so the admission
+// webhook in this package uses the exact same derivation to reject two header keys that would
+// otherwise collide on the same env var name (see validateExporterHeaders() in
+// instrumentation_webhook.go).
+func SecretHeaderEnvName(key string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, key)
+	return fmt.Sprintf("OTEL_EXPORTER_OTLP_HEADERS_%s", sanitized)
+}
@@ -0,0 +1,308 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstrumentationSpec defines the desired state of OpenTelemetry SDK and instrumentation.
+type InstrumentationSpec struct {
+	// Exporter defines exporter configuration.
+	// +optional
+	Exporter Exporter `json:"exporter,omitempty"`
+
+	// Propagators defines inter-process context propagation configuration.
+	// Values in this list will be set in the OTEL_PROPAGATORS env var.
+	// Enum=tracecontext;baggage;b3;b3multi;jaeger;xray;ottrace;none
+	// +optional
+	Propagators []Propagator `json:"propagators,omitempty"`
+
+	// Sampler defines sampling configuration.
+	// +optional
+	Sampler Sampler `json:"sampler,omitempty"`
+
+	// ResourceAttributes defines attributes that are added to the resource, as defined
+	// by the OpenTelemetry specification. For example environment: dev.
+	// +optional
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+
+	// LogsExporter defines which exporter to use for the logs signal, for example "otlp" or
+	// "none". Values in this field are set in the OTEL_LOGS_EXPORTER env var.
+	// +optional
+	LogsExporter string `json:"logsExporter,omitempty"`
+
+	// Traces defines traces signal specific configuration, layered on top of Exporter. When
+	// unset, the traces signal falls back to the aggregate exporter endpoint/protocol.
+	// +optional
+	Traces Traces `json:"traces,omitempty"`
+
+	// Metrics defines metrics signal specific configuration, layered on top of Exporter. When
+	// unset, the metrics signal falls back to the aggregate exporter endpoint/protocol.
+	// +optional
+	Metrics Metrics `json:"metrics,omitempty"`
+
+	// Logs defines logs signal specific configuration, layered on top of Exporter. When
+	// unset, the logs signal falls back to the aggregate exporter endpoint/protocol.
+	// +optional
+	Logs Logs `json:"logs,omitempty"`
+
+	// Env defines common env vars. The injector does not add these to the container.
+	// Env vars the container already declares are never overridden. The vars derived from the
+	// other fields of this spec are added before the language specific env vars, so a language
+	// specific env var with the same name as one of them is ignored.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Java defines configuration for java auto-instrumentation.
+	// +optional
+	Java JavaSpec `json:"java,omitempty"`
+
+	// NodeJS defines configuration for nodejs auto-instrumentation.
+	// +optional
+	NodeJS NodeJSSpec `json:"nodejs,omitempty"`
+
+	// Python defines configuration for python auto-instrumentation.
+	// +optional
+	Python PythonSpec `json:"python,omitempty"`
+
+	// DotNet defines configuration for .NET auto-instrumentation.
+	// +optional
+	DotNet DotNetSpec `json:"dotnet,omitempty"`
+}
+
+// Exporter defines OTLP exporter configuration.
+type Exporter struct {
+	// Endpoint is address of the collector with OTLP endpoint.
+	// If protocol is http, the appropriate path is required in the endpoint.
+	// For example, for OTLP/HTTP traces, it should be
+	// http://localhost:4318/v1/traces.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Headers defines the headers that will be added via the OTEL_EXPORTER_OTLP_HEADERS env var.
+	// Each value is either a literal string or sourced from a Secret so credentials never need
+	// to be declared in the Instrumentation CR directly.
+	// +optional
+	Headers map[string]ExporterHeader `json:"headers,omitempty"`
+
+	// Protocol defines the OTLP protocol used for all signals, set in OTEL_EXPORTER_OTLP_PROTOCOL.
+	// Overridden per-signal by Traces.Protocol, Metrics.Protocol, and Logs.Protocol.
+	// Enum=grpc;http/protobuf;http/json
+	// +optional
+	Protocol OTLPProtocol `json:"protocol,omitempty"`
+}
+
+// OTLPProtocol is the wire protocol used by an OTLP exporter.
+type OTLPProtocol string
+
+const (
+	OTLPProtocolGRPC         OTLPProtocol = "grpc"
+	OTLPProtocolHTTPProtobuf OTLPProtocol = "http/protobuf"
+	OTLPProtocolHTTPJSON     OTLPProtocol = "http/json"
+)
+
+// Traces defines the configuration for the traces signal.
+type Traces struct {
+	// Endpoint is the OTLP endpoint used to export traces. When unset, the traces signal
+	// falls back to spec.exporter.endpoint.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Protocol is the OTLP protocol used to export traces. When unset, the traces signal
+	// falls back to spec.exporter.protocol.
+	// +optional
+	Protocol OTLPProtocol `json:"protocol,omitempty"`
+}
+
+// Metrics defines the configuration for the metrics signal.
+type Metrics struct {
+	// Endpoint is the OTLP endpoint used to export metrics. When unset, the metrics signal
+	// falls back to spec.exporter.endpoint.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Protocol is the OTLP protocol used to export metrics. When unset, the metrics signal
+	// falls back to spec.exporter.protocol.
+	// +optional
+	Protocol OTLPProtocol `json:"protocol,omitempty"`
+}
+
+// ExporterHeader represents a single OTLP exporter header, with its value either given
+// literally or sourced from another resource, such as a Secret.
+type ExporterHeader struct {
+	// Value is the literal header value. Mutually exclusive with ValueFrom.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom sources the header value from a Secret key, so the credential itself never
+	// appears in the Instrumentation CR. The referenced value is injected as its own env var
+	// on the target container and referenced from the OTEL_EXPORTER_OTLP_HEADERS list.
+	// Setting this alongside Value is rejected by the admission webhook.
+	// +optional
+	ValueFrom *corev1.EnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// Logs defines the configuration for the logs signal.
+type Logs struct {
+	// Endpoint is the OTLP endpoint used to export logs. When unset, the logs signal falls
+	// back to spec.exporter.endpoint.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Protocol is the OTLP protocol used to export logs. When unset, the logs signal falls
+	// back to spec.exporter.protocol.
+	// +optional
+	Protocol OTLPProtocol `json:"protocol,omitempty"`
+}
+
+// Propagator represents the propagation type.
+type Propagator string
+
+const (
+	TraceContext Propagator = "tracecontext"
+	Baggage      Propagator = "baggage"
+	B3           Propagator = "b3"
+	B3Multi      Propagator = "b3multi"
+	Jaeger       Propagator = "jaeger"
+	XRay         Propagator = "xray"
+	OtTrace      Propagator = "ottrace"
+	None         Propagator = "none"
+)
+
+// Sampler defines sampling configuration.
+type Sampler struct {
+	// Type defines sampler type.
+	// Enum=parentbased_always_on;parentbased_always_off;parentbased_traceidratio;parentbased_jaeger_remote;always_on;always_off;traceidratio;jaeger_remote;xray
+	// +optional
+	Type SamplerType `json:"type,omitempty"`
+
+	// Argument defines sampler argument.
+	// The value depends on the sampler type.
+	// For instance for parentbased_traceidratio sampler type it is a number in range [0..1] e.g. 0.25.
+	// Human-friendly values such as "25%" or time-based units such as "250ms" are also accepted and
+	// normalized by the operator when it writes OTEL_TRACES_SAMPLER_ARG; the admission webhook
+	// rejects a value that cannot be interpreted for the sampler type.
+	// +optional
+	Argument string `json:"argument,omitempty"`
+}
+
+// SamplerType defines sampler type.
+type SamplerType string
+
+const (
+	ParentbasedAlwaysOn     SamplerType = "parentbased_always_on"
+	ParentbasedAlwaysOff    SamplerType = "parentbased_always_off"
+	ParentbasedTraceIDRatio SamplerType = "parentbased_traceidratio"
+	ParentbasedJaegerRemote SamplerType = "parentbased_jaeger_remote"
+	AlwaysOn                SamplerType = "always_on"
+	AlwaysOff               SamplerType = "always_off"
+	TraceIDRatio            SamplerType = "traceidratio"
+	JaegerRemote            SamplerType = "jaeger_remote"
+	XRaySampler             SamplerType = "xray"
+)
+
+// JavaSpec defines Java specific attributes.
+type JavaSpec struct {
+	// Image is a container image with javaagent auto-instrumentation JAR.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Env defines java specific env vars.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources describes the compute resource requirements for the init container
+	// that copies the auto-instrumentation JAR into the application's container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// NodeJSSpec defines NodeJS specific attributes.
+type NodeJSSpec struct {
+	// Image is a container image with NodeJS SDK and auto-instrumentation.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Env defines nodejs specific env vars.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources describes the compute resource requirements for the init container
+	// that copies the auto-instrumentation into the application's container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// PythonSpec defines Python specific attributes.
+type PythonSpec struct {
+	// Image is a container image with the OpenTelemetry Python SDK and auto-instrumentation.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Env defines python specific env vars.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources describes the compute resource requirements for the init container
+	// that copies the auto-instrumentation into the application's container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// DotNetSpec defines .NET specific attributes.
+type DotNetSpec struct {
+	// Image is a container image with the OpenTelemetry .NET SDK and auto-instrumentation.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Env defines .NET specific env vars.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources describes the compute resource requirements for the init container
+	// that copies the auto-instrumentation into the application's container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// InstrumentationStatus defines status of the instrumentation.
+type InstrumentationStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=instrumentations,scope=Namespaced,categories=opentelemetry;all,shortName=otelinst
+// Instrumentation is the spec for OpenTelemetry instrumentation.
+type Instrumentation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstrumentationSpec   `json:"spec,omitempty"`
+	Status InstrumentationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// InstrumentationList contains a list of Instrumentation.
+type InstrumentationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instrumentation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Instrumentation{}, &InstrumentationList{})
+}
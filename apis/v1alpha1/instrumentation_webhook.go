@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+var instrumentationlog = logf.Log.WithName("instrumentation-resource")
+
+// AutoInstrumentationImageDefaults holds the operator-configured default images used to fill in
+// an Instrumentation CR's per-language Image field when the user leaves it empty.
+type AutoInstrumentationImageDefaults struct {
+	Python string
+	DotNet string
+}
+
+// DefaultAutoInstrumentationImages are the operator's built-in default images. The operator
+// overwrites this from its own config/flags before the webhook server starts.
+var DefaultAutoInstrumentationImages = AutoInstrumentationImageDefaults{
+	Python: "ghcr.io/open-telemetry/opentelemetry-operator/autoinstrumentation-python:latest",
+	DotNet: "ghcr.io/open-telemetry/opentelemetry-operator/autoinstrumentation-dotnet:latest",
+}
+
+func (r *Instrumentation) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-opentelemetry-io-v1alpha1-instrumentation,mutating=true,failurePolicy=fail,sideEffects=None,groups=opentelemetry.io,resources=instrumentations,verbs=create;update,versions=v1alpha1,name=minstrumentation.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Instrumentation{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type, filling in
+// the operator's default Python and .NET auto-instrumentation images when the user didn't set
+// one. Java and NodeJS images are not defaulted.
+func (r *Instrumentation) Default() {
+	instrumentationlog.V(1).Info("default", "name", r.Name)
+	if r.Spec.Python.Image == "" {
+		r.Spec.Python.Image = DefaultAutoInstrumentationImages.Python
+	}
+	if r.Spec.DotNet.Image == "" {
+		r.Spec.DotNet.Image = DefaultAutoInstrumentationImages.DotNet
+	}
+}
+
+// +kubebuilder:webhook:path=/validate-opentelemetry-io-v1alpha1-instrumentation,mutating=false,failurePolicy=fail,sideEffects=None,groups=opentelemetry.io,resources=instrumentations,verbs=create;update,versions=v1alpha1,name=vinstrumentationcreateupdate.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Instrumentation{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *Instrumentation) ValidateCreate() error {
+	instrumentationlog.V(1).Info("validate create", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *Instrumentation) ValidateUpdate(_ runtime.Object) error {
+	instrumentationlog.V(1).Info("validate update", "name", r.Name)
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *Instrumentation) ValidateDelete() error {
+	return nil
+}
+
+func (r *Instrumentation) validate() error {
+	if r.Spec.Sampler.Argument != "" {
+		if _, err := ParseSamplerArgument(r.Spec.Sampler.Type, r.Spec.Sampler.Argument); err != nil {
+			return fmt.Errorf("spec.sampler.argument is invalid: %w", err)
+		}
+	}
+	if err := validateExporterHeaders(r.Spec.Exporter.Headers); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateExporterHeaders rejects header configurations the injector cannot honor faithfully:
+// a header that sets both Value and ValueFrom (the doc comment on ExporterHeader promises these
+// are mutually exclusive), and two header keys that sanitize to the same
+// OTEL_EXPORTER_OTLP_HEADERS_* env var name via SecretHeaderEnvName, which would otherwise cause
+// the second key to silently carry the first key's secret value.
+func validateExporterHeaders(headers map[string]ExporterHeader) error {
+	envNames := make(map[string]string, len(headers))
+	for key, header := range headers {
+		if header.Value != "" && header.ValueFrom != nil {
+			return fmt.Errorf("spec.exporter.headers[%s] sets both value and valueFrom, which are mutually exclusive", key)
+		}
+		if header.ValueFrom == nil {
+			continue
+		}
+		envName := SecretHeaderEnvName(key)
+		if other, ok := envNames[envName]; ok {
+			return fmt.Errorf("spec.exporter.headers[%s] and spec.exporter.headers[%s] both derive the env var %s, rename one of the header keys to avoid the collision", other, key, envName)
+		}
+		envNames[envName] = key
+	}
+	return nil
+}
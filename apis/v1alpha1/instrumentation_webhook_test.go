@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateExporterHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]ExporterHeader
+		wantErr bool
+	}{
+		{
+			name: "literal and secret-sourced headers with distinct keys",
+			headers: map[string]ExporterHeader{
+				"authorization": {Value: "Bearer token"},
+				"api-key": {
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+							Key:                  "api-key",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "value and valueFrom both set",
+			headers: map[string]ExporterHeader{
+				"authorization": {
+					Value: "Bearer token",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "two keys collide on the same derived env var",
+			headers: map[string]ExporterHeader{
+				"Authorization": {
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+							Key:                  "one",
+						},
+					},
+				},
+				"authorization": {
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+							Key:                  "two",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateExporterHeaders(test.headers)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestInstrumentationValidateCreate(t *testing.T) {
+	inst := &Instrumentation{
+		Spec: InstrumentationSpec{
+			Exporter: Exporter{
+				Headers: map[string]ExporterHeader{
+					"Authorization": {
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+								Key:                  "one",
+							},
+						},
+					},
+					"authorization": {
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+								Key:                  "two",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Error(t, inst.ValidateCreate())
+}
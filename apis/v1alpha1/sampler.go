@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseSamplerArgument normalizes a Sampler.Argument value into the raw numeric string the
+// OpenTelemetry SDK expects for OTEL_TRACES_SAMPLER_ARG. Ratio-based samplers additionally accept
+// a percentage (e.g. "25%") or a duration (e.g. "250ms"), both of which are converted to a ratio
+// in [0, 1]. Rate-limiting samplers accept a percentage or a duration, converted to a number of
+// traces per second. For both, a plain number is accepted and re-rendered without trailing zeros
+// (e.g. "0.50" becomes "0.5"). Other sampler types take only a plain number, returned unchanged.
+//
+// This lives in v1alpha1 rather than pkg/instrumentation (where the originating request placed
+// it) because pkg/instrumentation already imports v1alpha1 for the Instrumentation types; the
+// admission webhook in this package needs the same parser to validate on create/update, and
+// putting it in pkg/instrumentation would create an import cycle.
+func ParseSamplerArgument(samplerType SamplerType, argument string) (string, error) {
+	arg := strings.TrimSpace(argument)
+	if arg == "" {
+		return "", nil
+	}
+
+	switch samplerType {
+	case ParentbasedTraceIDRatio, TraceIDRatio:
+		ratio, err := ParseHumanQuantity(arg)
+		if err != nil {
+			return "", fmt.Errorf("sampler argument %q is not a valid ratio, percentage, or duration: %w", argument, err)
+		}
+		if ratio < 0 || ratio > 1 {
+			return "", fmt.Errorf("sampler argument %q resolves to %v, which is outside the valid ratio range [0, 1]", argument, ratio)
+		}
+		return formatSamplerNumber(ratio), nil
+	case ParentbasedJaegerRemote, JaegerRemote:
+		rate, err := ParseHumanQuantity(arg)
+		if err != nil {
+			return "", fmt.Errorf("sampler argument %q is not a valid rate, percentage, or duration: %w", argument, err)
+		}
+		return formatSamplerNumber(rate), nil
+	default:
+		if _, err := strconv.ParseFloat(arg, 64); err != nil {
+			return "", fmt.Errorf("sampler type %q does not accept the human-readable argument %q, use a plain number instead", samplerType, argument)
+		}
+		return arg, nil
+	}
+}
+
+// ParseHumanQuantity parses a human-friendly quantity - a percentage ("25%"), a duration
+// ("250ms", "5s"), a byte size ("1MiB"), or a plain number - into its base numeric value:
+// percentages are divided by 100 (e.g. "25%" becomes 0.25, "150%" becomes 1.5; the result is not
+// range-checked), durations become a number of seconds, and byte sizes
+// become a raw byte count. It is shared by Sampler.Argument today and is intended for reuse by
+// future human-friendly numeric fields on InstrumentationSpec.
+func ParseHumanQuantity(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("value is empty")
+	}
+
+	if strings.HasSuffix(value, "%") {
+		pct, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid percentage %q: %w", value, err)
+		}
+		return pct / 100, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil {
+		return d.Seconds(), nil
+	}
+
+	if bytes, ok := parseByteSize(value); ok {
+		return bytes, nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number, a percentage, a duration, or a byte size", value)
+	}
+	return f, nil
+}
+
+var byteSizeUnits = []struct {
+	suffix string
+	factor float64
+}{
+	{"GiB", 1 << 30},
+	{"MiB", 1 << 20},
+	{"KiB", 1 << 10},
+	{"GB", 1e9},
+	{"MB", 1e6},
+	{"KB", 1e3},
+	{"B", 1},
+}
+
+// parseByteSize parses a size such as "1MiB" or "512KB" into a raw byte count.
+func parseByteSize(value string) (float64, bool) {
+	for _, unit := range byteSizeUnits {
+		if !strings.HasSuffix(value, unit.suffix) {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.TrimSuffix(value, unit.suffix), 64)
+		if err != nil || n < 0 {
+			return 0, false
+		}
+		return n * unit.factor, true
+	}
+	return 0, false
+}
+
+// formatSamplerNumber renders a float64 the way the SDK expects, without a forced decimal point
+// or trailing zeros (e.g. 0.25, not 0.250000).
+func formatSamplerNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSamplerArgument(t *testing.T) {
+	tests := []struct {
+		name        string
+		samplerType SamplerType
+		argument    string
+		expected    string
+		wantErr     bool
+	}{
+		{
+			name:        "plain ratio is unchanged",
+			samplerType: ParentbasedTraceIDRatio,
+			argument:    "0.25",
+			expected:    "0.25",
+		},
+		{
+			name:        "percentage is converted to a ratio",
+			samplerType: ParentbasedTraceIDRatio,
+			argument:    "25%",
+			expected:    "0.25",
+		},
+		{
+			name:        "duration is converted to a ratio via seconds",
+			samplerType: TraceIDRatio,
+			argument:    "250ms",
+			expected:    "0.25",
+		},
+		{
+			name:        "ratio out of range is rejected",
+			samplerType: ParentbasedTraceIDRatio,
+			argument:    "150%",
+			wantErr:     true,
+		},
+		{
+			name:        "duration is converted to a per-second rate",
+			samplerType: ParentbasedJaegerRemote,
+			argument:    "250ms",
+			expected:    "0.25",
+		},
+		{
+			name:        "percentage is converted to a rate",
+			samplerType: JaegerRemote,
+			argument:    "25%",
+			expected:    "0.25",
+		},
+		{
+			name:        "always_on does not accept a human-readable argument",
+			samplerType: AlwaysOn,
+			argument:    "25%",
+			wantErr:     true,
+		},
+		{
+			name:        "always_on accepts a plain number unchanged",
+			samplerType: AlwaysOn,
+			argument:    "5",
+			expected:    "5",
+		},
+		{
+			name:        "empty argument is left empty",
+			samplerType: ParentbasedTraceIDRatio,
+			argument:    "",
+			expected:    "",
+		},
+		{
+			name:        "unparseable argument is rejected",
+			samplerType: ParentbasedTraceIDRatio,
+			argument:    "not-a-number",
+			wantErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseSamplerArgument(test.samplerType, test.argument)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestParseHumanQuantity(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected float64
+		wantErr  bool
+	}{
+		{name: "percentage", value: "25%", expected: 0.25},
+		{name: "milliseconds", value: "250ms", expected: 0.25},
+		{name: "seconds", value: "5s", expected: 5},
+		{name: "mebibytes", value: "1MiB", expected: 1048576},
+		{name: "kilobytes", value: "2KB", expected: 2000},
+		{name: "plain number", value: "42", expected: 42},
+		{name: "empty value is rejected", value: "", wantErr: true},
+		{name: "garbage value is rejected", value: "not-a-quantity", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseHumanQuantity(test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
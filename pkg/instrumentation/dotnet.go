@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumentation
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-operator/apis/v1alpha1"
+)
+
+const (
+	envDotNetCoreClrEnableProfiling = "CORECLR_ENABLE_PROFILING"
+	envDotNetCoreClrProfiler        = "CORECLR_PROFILER"
+	envDotNetCoreClrProfilerPath    = "CORECLR_PROFILER_PATH"
+	envDotNetStartupHooks           = "DOTNET_STARTUP_HOOKS"
+
+	dotNetCoreClrEnableProfilingValue = "1"
+	dotNetCoreClrProfilerID           = "{918728DD-259F-4A6A-AC2B-B85E1B658318}"
+	dotNetCoreClrProfilerPathValue    = "/otel-auto-instrumentation/OpenTelemetry.AutoInstrumentation.Native.so"
+	dotNetStartupHookPath             = "/otel-auto-instrumentation/netcoreapp3.1/OpenTelemetry.AutoInstrumentation.StartupHook.dll"
+
+	dotNetInitContainerMountPath = "/otel-auto-instrumentation"
+)
+
+// injectDotNetSDK injects the .NET auto-instrumentation SDK into the first container of the pod.
+func injectDotNetSDK(logger logr.Logger, dotNetSpec v1alpha1.Instrumentation, ns corev1.Namespace, pod corev1.Pod) corev1.Pod {
+	pod = injectCommonSDKConfig(dotNetSpec, ns, pod)
+	container := &pod.Spec.Containers[0]
+
+	for _, env := range dotNetSpec.Spec.DotNet.Env {
+		if getIndexOfEnv(container.Env, env.Name) == -1 {
+			container.Env = append(container.Env, env)
+		}
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: dotNetInitContainerMountPath,
+	})
+
+	setDotNetEnvVars(container, dotNetStartupHookPath)
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:      initContainerName,
+		Image:     dotNetSpec.Spec.DotNet.Image,
+		Command:   []string{"cp", "-a", "/autoinstrumentation/.", "/otel-auto-instrumentation/"},
+		Resources: dotNetSpec.Spec.DotNet.Resources,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      volumeName,
+				MountPath: dotNetInitContainerMountPath,
+			},
+		},
+	})
+
+	return pod
+}
+
+// setDotNetEnvVars sets the CLR profiler env vars that enable the .NET auto-instrumentation,
+// leaving alone any of the single-value profiler vars the application container has already set
+// explicitly. DOTNET_STARTUP_HOOKS is a `;`-delimited list like JAVA_TOOL_OPTIONS/NODE_OPTIONS, so
+// the startup hook is appended to any existing value instead of being skipped.
+func setDotNetEnvVars(container *corev1.Container, startupHook string) {
+	if getIndexOfEnv(container.Env, envDotNetCoreClrEnableProfiling) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envDotNetCoreClrEnableProfiling,
+			Value: dotNetCoreClrEnableProfilingValue,
+		})
+	}
+	if getIndexOfEnv(container.Env, envDotNetCoreClrProfiler) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envDotNetCoreClrProfiler,
+			Value: dotNetCoreClrProfilerID,
+		})
+	}
+	if getIndexOfEnv(container.Env, envDotNetCoreClrProfilerPath) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envDotNetCoreClrProfilerPath,
+			Value: dotNetCoreClrProfilerPathValue,
+		})
+	}
+	setDotNetStartupHooks(container, startupHook)
+}
+
+// setDotNetStartupHooks sets DOTNET_STARTUP_HOOKS, appending to any value already present on the
+// container instead of overwriting it, consistent with setJavaToolOptions/setNodeOptions.
+func setDotNetStartupHooks(container *corev1.Container, value string) {
+	idx := getIndexOfEnv(container.Env, envDotNetStartupHooks)
+	if idx == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envDotNetStartupHooks,
+			Value: value,
+		})
+		return
+	}
+	container.Env[idx].Value = container.Env[idx].Value + ";" + value
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumentation
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-operator/apis/v1alpha1"
+)
+
+const (
+	volumeName        = "opentelemetry-auto-instrumentation"
+	initContainerName = "opentelemetry-auto-instrumentation"
+)
+
+// languageInstrumentations holds instances for each language that is attempting
+// to be injected into the pod via the opentelemetry.io annotations.
+type languageInstrumentations struct {
+	Java   *v1alpha1.Instrumentation
+	NodeJS *v1alpha1.Instrumentation
+	Python *v1alpha1.Instrumentation
+	DotNet *v1alpha1.Instrumentation
+}
+
+// inject injects the requested language auto-instrumentations into the first container of the pod.
+func inject(logger logr.Logger, insts languageInstrumentations, ns corev1.Namespace, pod corev1.Pod) corev1.Pod {
+	if len(pod.Spec.Containers) < 1 {
+		logger.Info("skipping instrumentation injection, pod has no containers")
+		return pod
+	}
+
+	if insts.Java != nil {
+		pod = injectJavaagent(logger, *insts.Java, ns, pod)
+	}
+
+	if insts.NodeJS != nil {
+		pod = injectNodeJSSDK(logger, *insts.NodeJS, ns, pod)
+	}
+
+	if insts.Python != nil {
+		pod = injectPythonSDK(logger, *insts.Python, ns, pod)
+	}
+
+	if insts.DotNet != nil {
+		pod = injectDotNetSDK(logger, *insts.DotNet, ns, pod)
+	}
+
+	return pod
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumentation
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-operator/apis/v1alpha1"
+)
+
+const (
+	envJavaToolsOptions        = "JAVA_TOOL_OPTIONS"
+	javaJVMArgument            = "-javaagent:/otel-auto-instrumentation/javaagent.jar"
+	javaInitContainerMountPath = "/otel-auto-instrumentation"
+
+	// envJavaLogsEnabled turns on the javaagent's log appender instrumentation, which is
+	// disabled by default because it is still experimental upstream.
+	envJavaLogsEnabled = "OTEL_INSTRUMENTATION_JAVA_LOGS_ENABLED"
+)
+
+// injectJavaagent injects the Java auto-instrumentation javaagent into the first container of the pod.
+func injectJavaagent(logger logr.Logger, javaSpec v1alpha1.Instrumentation, ns corev1.Namespace, pod corev1.Pod) corev1.Pod {
+	pod = injectCommonSDKConfig(javaSpec, ns, pod)
+	container := &pod.Spec.Containers[0]
+
+	for _, env := range javaSpec.Spec.Java.Env {
+		if getIndexOfEnv(container.Env, env.Name) == -1 {
+			container.Env = append(container.Env, env)
+		}
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: javaInitContainerMountPath,
+	})
+
+	setJavaToolOptions(container, javaJVMArgument)
+
+	if javaSpec.Spec.LogsExporter != "" && getIndexOfEnv(container.Env, envJavaLogsEnabled) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envJavaLogsEnabled,
+			Value: "true",
+		})
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:      initContainerName,
+		Image:     javaSpec.Spec.Java.Image,
+		Command:   []string{"cp", "/javaagent.jar", "/otel-auto-instrumentation/javaagent.jar"},
+		Resources: javaSpec.Spec.Java.Resources,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      volumeName,
+				MountPath: javaInitContainerMountPath,
+			},
+		},
+	})
+
+	return pod
+}
+
+// setJavaToolOptions sets JAVA_TOOL_OPTIONS, appending to any value already present on the container.
+func setJavaToolOptions(container *corev1.Container, value string) {
+	idx := getIndexOfEnv(container.Env, envJavaToolsOptions)
+	if idx == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envJavaToolsOptions,
+			Value: value,
+		})
+		return
+	}
+	container.Env[idx].Value = container.Env[idx].Value + " " + value
+}
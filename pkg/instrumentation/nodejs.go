@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumentation
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-operator/apis/v1alpha1"
+)
+
+const (
+	envNodeOptions             = "NODE_OPTIONS"
+	nodeRequireArgument        = "--require /otel-auto-instrumentation/autoinstrumentation.js"
+	nodeInitContainerMountPath = "/otel-auto-instrumentation"
+
+	// envNodeLogsEnabled turns on the experimental log-record exporting supported by the
+	// NodeJS auto-instrumentation, which is opt-in upstream.
+	envNodeLogsEnabled = "OTEL_NODE_ENABLE_EXPERIMENTAL_LOGS"
+)
+
+// injectNodeJSSDK injects the NodeJS auto-instrumentation SDK into the first container of the pod.
+func injectNodeJSSDK(logger logr.Logger, nodeJSSpec v1alpha1.Instrumentation, ns corev1.Namespace, pod corev1.Pod) corev1.Pod {
+	pod = injectCommonSDKConfig(nodeJSSpec, ns, pod)
+	container := &pod.Spec.Containers[0]
+
+	for _, env := range nodeJSSpec.Spec.NodeJS.Env {
+		if getIndexOfEnv(container.Env, env.Name) == -1 {
+			container.Env = append(container.Env, env)
+		}
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: nodeInitContainerMountPath,
+	})
+
+	setNodeOptions(container, nodeRequireArgument)
+
+	if nodeJSSpec.Spec.LogsExporter != "" && getIndexOfEnv(container.Env, envNodeLogsEnabled) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envNodeLogsEnabled,
+			Value: "true",
+		})
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:      initContainerName,
+		Image:     nodeJSSpec.Spec.NodeJS.Image,
+		Command:   []string{"cp", "-a", "/autoinstrumentation/.", "/otel-auto-instrumentation/"},
+		Resources: nodeJSSpec.Spec.NodeJS.Resources,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      volumeName,
+				MountPath: nodeInitContainerMountPath,
+			},
+		},
+	})
+
+	return pod
+}
+
+// setNodeOptions sets NODE_OPTIONS, appending to any value already present on the container.
+func setNodeOptions(container *corev1.Container, value string) {
+	idx := getIndexOfEnv(container.Env, envNodeOptions)
+	if idx == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envNodeOptions,
+			Value: value,
+		})
+		return
+	}
+	container.Env[idx].Value = container.Env[idx].Value + " " + value
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumentation
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-operator/apis/v1alpha1"
+)
+
+const (
+	envPythonPath                = "PYTHONPATH"
+	pythonPathPrefix             = "/otel-auto-instrumentation"
+	pythonInitContainerMountPath = "/otel-auto-instrumentation"
+)
+
+// injectPythonSDK injects the Python auto-instrumentation SDK into the first container of the pod.
+func injectPythonSDK(logger logr.Logger, pythonSpec v1alpha1.Instrumentation, ns corev1.Namespace, pod corev1.Pod) corev1.Pod {
+	pod = injectCommonSDKConfig(pythonSpec, ns, pod)
+	container := &pod.Spec.Containers[0]
+
+	for _, env := range pythonSpec.Spec.Python.Env {
+		if getIndexOfEnv(container.Env, env.Name) == -1 {
+			container.Env = append(container.Env, env)
+		}
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: pythonInitContainerMountPath,
+	})
+
+	setPythonPath(container, pythonPathPrefix)
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:      initContainerName,
+		Image:     pythonSpec.Spec.Python.Image,
+		Command:   []string{"cp", "-a", "/autoinstrumentation/.", "/otel-auto-instrumentation/"},
+		Resources: pythonSpec.Spec.Python.Resources,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      volumeName,
+				MountPath: pythonInitContainerMountPath,
+			},
+		},
+	})
+
+	return pod
+}
+
+// setPythonPath prepends the auto-instrumentation directory to PYTHONPATH, so the interpreter
+// finds the injected sitecustomize.py ahead of anything the application's own image provides.
+func setPythonPath(container *corev1.Container, prefix string) {
+	idx := getIndexOfEnv(container.Env, envPythonPath)
+	if idx == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envPythonPath,
+			Value: prefix,
+		})
+		return
+	}
+	container.Env[idx].Value = prefix + ":" + container.Env[idx].Value
+}
@@ -0,0 +1,232 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumentation
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-operator/apis/v1alpha1"
+)
+
+const (
+	envOTELServiceName          = "OTEL_SERVICE_NAME"
+	envOTELExporterOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envOTELExporterOTLPProtocol = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	envOTELExporterOTLPHeaders  = "OTEL_EXPORTER_OTLP_HEADERS"
+	envOTELResourceAttrs        = "OTEL_RESOURCE_ATTRIBUTES"
+	envOTELPropagators          = "OTEL_PROPAGATORS"
+	envOTELTracesSampler        = "OTEL_TRACES_SAMPLER"
+	envOTELTracesSamplerArg     = "OTEL_TRACES_SAMPLER_ARG"
+	envOTELLogsExporter         = "OTEL_LOGS_EXPORTER"
+
+	envOTELExporterOTLPTracesEndpoint  = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	envOTELExporterOTLPTracesProtocol  = "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"
+	envOTELExporterOTLPMetricsEndpoint = "OTEL_EXPORTER_OTLP_METRICS_ENDPOINT"
+	envOTELExporterOTLPMetricsProtocol = "OTEL_EXPORTER_OTLP_METRICS_PROTOCOL"
+	envOTELExporterOTLPLogsEndpoint    = "OTEL_EXPORTER_OTLP_LOGS_ENDPOINT"
+	envOTELExporterOTLPLogsProtocol    = "OTEL_EXPORTER_OTLP_LOGS_PROTOCOL"
+)
+
+// injectCommonSDKConfig adds the common SDK configuration env vars (the ones that are not specific
+// to a given language auto-instrumentation) to the first container of the pod.
+func injectCommonSDKConfig(otelinst v1alpha1.Instrumentation, ns corev1.Namespace, pod corev1.Pod) corev1.Pod {
+	container := &pod.Spec.Containers[0]
+
+	if getIndexOfEnv(container.Env, envOTELServiceName) == -1 {
+		serviceName := container.Name
+		if serviceName == "" {
+			serviceName = pod.Name
+		}
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envOTELServiceName,
+			Value: serviceName,
+		})
+	}
+
+	if otelinst.Spec.Exporter.Endpoint != "" && getIndexOfEnv(container.Env, envOTELExporterOTLPEndpoint) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envOTELExporterOTLPEndpoint,
+			Value: otelinst.Spec.Exporter.Endpoint,
+		})
+	}
+
+	if otelinst.Spec.Exporter.Protocol != "" && getIndexOfEnv(container.Env, envOTELExporterOTLPProtocol) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envOTELExporterOTLPProtocol,
+			Value: string(otelinst.Spec.Exporter.Protocol),
+		})
+	}
+
+	injectExporterHeaders(otelinst, container)
+
+	idx := getIndexOfEnv(container.Env, envOTELResourceAttrs)
+	attrs := resourceAttributes(otelinst.Spec.ResourceAttributes, container.Name, ns.Name, pod.Name)
+	if idx == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envOTELResourceAttrs,
+			Value: attrs,
+		})
+	} else {
+		container.Env[idx].Value = container.Env[idx].Value + attrs
+	}
+
+	if len(otelinst.Spec.Propagators) > 0 && getIndexOfEnv(container.Env, envOTELPropagators) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envOTELPropagators,
+			Value: joinPropagators(otelinst.Spec.Propagators),
+		})
+	}
+
+	if otelinst.Spec.Sampler.Type != "" && getIndexOfEnv(container.Env, envOTELTracesSampler) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envOTELTracesSampler,
+			Value: string(otelinst.Spec.Sampler.Type),
+		})
+		if otelinst.Spec.Sampler.Argument != "" {
+			arg := otelinst.Spec.Sampler.Argument
+			// The webhook should already have rejected an unparseable argument; fall back to
+			// the raw value rather than drop it if one slipped through some other path.
+			if normalized, err := v1alpha1.ParseSamplerArgument(otelinst.Spec.Sampler.Type, arg); err == nil {
+				arg = normalized
+			}
+			container.Env = append(container.Env, corev1.EnvVar{
+				Name:  envOTELTracesSamplerArg,
+				Value: arg,
+			})
+		}
+	}
+
+	if otelinst.Spec.LogsExporter != "" && getIndexOfEnv(container.Env, envOTELLogsExporter) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envOTELLogsExporter,
+			Value: otelinst.Spec.LogsExporter,
+		})
+	}
+
+	injectSignalConfig(container, envOTELExporterOTLPTracesEndpoint, envOTELExporterOTLPTracesProtocol, otelinst.Spec.Traces.Endpoint, otelinst.Spec.Traces.Protocol)
+	injectSignalConfig(container, envOTELExporterOTLPMetricsEndpoint, envOTELExporterOTLPMetricsProtocol, otelinst.Spec.Metrics.Endpoint, otelinst.Spec.Metrics.Protocol)
+	injectSignalConfig(container, envOTELExporterOTLPLogsEndpoint, envOTELExporterOTLPLogsProtocol, otelinst.Spec.Logs.Endpoint, otelinst.Spec.Logs.Protocol)
+
+	pod.Spec.Containers[0] = *container
+	return pod
+}
+
+// injectSignalConfig sets the per-signal OTLP endpoint/protocol env vars when the CR declares
+// them, leaving the signal to fall back to the aggregate OTEL_EXPORTER_OTLP_* vars otherwise -
+// the OpenTelemetry SDKs already implement that fallback, so the operator only needs to emit
+// the per-signal vars when they differ from the aggregate ones.
+func injectSignalConfig(container *corev1.Container, envEndpoint, envProtocol, endpoint string, protocol v1alpha1.OTLPProtocol) {
+	if endpoint != "" && getIndexOfEnv(container.Env, envEndpoint) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envEndpoint,
+			Value: endpoint,
+		})
+	}
+	if protocol != "" && getIndexOfEnv(container.Env, envProtocol) == -1 {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  envProtocol,
+			Value: string(protocol),
+		})
+	}
+}
+
+// injectExporterHeaders sets OTEL_EXPORTER_OTLP_HEADERS from spec.exporter.headers, unless the
+// container already declares it explicitly. Headers sourced from a Secret are injected as their
+// own env var on the container and referenced from the header list via the `$(VAR)` env var
+// expansion supported by the Pod spec, so the credential itself never needs to live in the CR.
+// The admission webhook rejects two header keys that would derive the same env var name via
+// v1alpha1.SecretHeaderEnvName, so distinct keys here are guaranteed not to collide.
+func injectExporterHeaders(otelinst v1alpha1.Instrumentation, container *corev1.Container) {
+	if len(otelinst.Spec.Exporter.Headers) == 0 || getIndexOfEnv(container.Env, envOTELExporterOTLPHeaders) > -1 {
+		return
+	}
+
+	keys := make([]string, 0, len(otelinst.Spec.Exporter.Headers))
+	for k := range otelinst.Spec.Exporter.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		header := otelinst.Spec.Exporter.Headers[key]
+		if header.ValueFrom != nil {
+			envName := v1alpha1.SecretHeaderEnvName(key)
+			if getIndexOfEnv(container.Env, envName) == -1 {
+				container.Env = append(container.Env, corev1.EnvVar{
+					Name:      envName,
+					ValueFrom: header.ValueFrom,
+				})
+			}
+			pairs = append(pairs, fmt.Sprintf("%s=$(%s)", key, envName))
+			continue
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, url.QueryEscape(header.Value)))
+	}
+
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  envOTELExporterOTLPHeaders,
+		Value: strings.Join(pairs, ","),
+	})
+}
+
+// resourceAttributes builds the OTEL_RESOURCE_ATTRIBUTES value, combining any user-defined
+// attributes with the Kubernetes resource attributes the operator sets. The namespace name is
+// always set; the container and pod names are set only when they are not empty.
+func resourceAttributes(attrs map[string]string, containerName, namespaceName, podName string) string {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys)+3)
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, attrs[k]))
+	}
+	if containerName != "" {
+		parts = append(parts, fmt.Sprintf("k8s.container.name=%s", containerName))
+	}
+	parts = append(parts, fmt.Sprintf("k8s.namespace.name=%s", namespaceName))
+	if podName != "" {
+		parts = append(parts, fmt.Sprintf("k8s.pod.name=%s", podName))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// joinPropagators renders the Propagators list as the comma-separated value OTEL_PROPAGATORS expects.
+func joinPropagators(propagators []v1alpha1.Propagator) string {
+	strs := make([]string, len(propagators))
+	for i, p := range propagators {
+		strs[i] = string(p)
+	}
+	return strings.Join(strs, ",")
+}
+
+// getIndexOfEnv returns the index of the env var with the given name, or -1 if it is not present.
+func getIndexOfEnv(envs []corev1.EnvVar, name string) int {
+	for i := range envs {
+		if envs[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
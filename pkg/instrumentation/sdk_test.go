@@ -185,6 +185,339 @@ func TestSDKInjection(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SDK sampler argument normalized from a human-readable value",
+			inst: v1alpha1.Instrumentation{
+				Spec: v1alpha1.InstrumentationSpec{
+					Exporter: v1alpha1.Exporter{
+						Endpoint: "https://collector:4317",
+					},
+					Sampler: v1alpha1.Sampler{
+						Type:     "parentbased_traceidratio",
+						Argument: "25%",
+					},
+				},
+			},
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+						},
+					},
+				},
+			},
+			expected: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "OTEL_SERVICE_NAME",
+									Value: "application-name",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+									Value: "https://collector:4317",
+								},
+								{
+									Name:  "OTEL_RESOURCE_ATTRIBUTES",
+									Value: "k8s.container.name=application-name,k8s.namespace.name=project1,k8s.pod.name=app",
+								},
+								{
+									Name:  "OTEL_TRACES_SAMPLER",
+									Value: "parentbased_traceidratio",
+								},
+								{
+									Name:  "OTEL_TRACES_SAMPLER_ARG",
+									Value: "0.25",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SDK headers with literal value",
+			inst: v1alpha1.Instrumentation{
+				Spec: v1alpha1.InstrumentationSpec{
+					Exporter: v1alpha1.Exporter{
+						Endpoint: "https://collector:4317",
+						Headers: map[string]v1alpha1.ExporterHeader{
+							"authorization": {Value: "Bearer token with spaces"},
+						},
+					},
+				},
+			},
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+						},
+					},
+				},
+			},
+			expected: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "OTEL_SERVICE_NAME",
+									Value: "application-name",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+									Value: "https://collector:4317",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_HEADERS",
+									Value: "authorization=Bearer+token+with+spaces",
+								},
+								{
+									Name:  "OTEL_RESOURCE_ATTRIBUTES",
+									Value: "k8s.container.name=application-name,k8s.namespace.name=project1,k8s.pod.name=app",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SDK headers sourced from a secret",
+			inst: v1alpha1.Instrumentation{
+				Spec: v1alpha1.InstrumentationSpec{
+					Exporter: v1alpha1.Exporter{
+						Endpoint: "https://collector:4317",
+						Headers: map[string]v1alpha1.ExporterHeader{
+							"api-key": {
+								ValueFrom: &corev1.EnvVarSource{
+									SecretKeyRef: &corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+										Key:                  "api-key",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+						},
+					},
+				},
+			},
+			expected: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "OTEL_SERVICE_NAME",
+									Value: "application-name",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+									Value: "https://collector:4317",
+								},
+								{
+									Name: "OTEL_EXPORTER_OTLP_HEADERS_API_KEY",
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-creds"},
+											Key:                  "api-key",
+										},
+									},
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_HEADERS",
+									Value: "api-key=$(OTEL_EXPORTER_OTLP_HEADERS_API_KEY)",
+								},
+								{
+									Name:  "OTEL_RESOURCE_ATTRIBUTES",
+									Value: "k8s.container.name=application-name,k8s.namespace.name=project1,k8s.pod.name=app",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SDK logs env vars",
+			inst: v1alpha1.Instrumentation{
+				Spec: v1alpha1.InstrumentationSpec{
+					Exporter: v1alpha1.Exporter{
+						Endpoint: "https://collector:4317",
+					},
+					LogsExporter: "otlp",
+					Logs: v1alpha1.Logs{
+						Endpoint: "https://collector:4317/v1/logs",
+					},
+				},
+			},
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+						},
+					},
+				},
+			},
+			expected: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "OTEL_SERVICE_NAME",
+									Value: "application-name",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+									Value: "https://collector:4317",
+								},
+								{
+									Name:  "OTEL_RESOURCE_ATTRIBUTES",
+									Value: "k8s.container.name=application-name,k8s.namespace.name=project1,k8s.pod.name=app",
+								},
+								{
+									Name:  "OTEL_LOGS_EXPORTER",
+									Value: "otlp",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_LOGS_ENDPOINT",
+									Value: "https://collector:4317/v1/logs",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SDK per-signal endpoints and protocols",
+			inst: v1alpha1.Instrumentation{
+				Spec: v1alpha1.InstrumentationSpec{
+					Exporter: v1alpha1.Exporter{
+						Endpoint: "https://collector:4317",
+						Protocol: v1alpha1.OTLPProtocolGRPC,
+					},
+					Traces: v1alpha1.Traces{
+						Endpoint: "https://collector:4318/v1/traces",
+						Protocol: v1alpha1.OTLPProtocolHTTPProtobuf,
+					},
+					Metrics: v1alpha1.Metrics{
+						Endpoint: "https://collector:4318/v1/metrics",
+						Protocol: v1alpha1.OTLPProtocolHTTPJSON,
+					},
+				},
+			},
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+						},
+					},
+				},
+			},
+			expected: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+					Name:      "app",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "application-name",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "OTEL_SERVICE_NAME",
+									Value: "application-name",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+									Value: "https://collector:4317",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_PROTOCOL",
+									Value: "grpc",
+								},
+								{
+									Name:  "OTEL_RESOURCE_ATTRIBUTES",
+									Value: "k8s.container.name=application-name,k8s.namespace.name=project1,k8s.pod.name=app",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+									Value: "https://collector:4318/v1/traces",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL",
+									Value: "http/protobuf",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_METRICS_ENDPOINT",
+									Value: "https://collector:4318/v1/metrics",
+								},
+								{
+									Name:  "OTEL_EXPORTER_OTLP_METRICS_PROTOCOL",
+									Value: "http/json",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -195,19 +528,186 @@ func TestSDKInjection(t *testing.T) {
 	}
 }
 
-func TestInjectJava(t *testing.T) {
+func TestInjectJava(t *testing.T) {
+	inst := v1alpha1.Instrumentation{
+		Spec: v1alpha1.InstrumentationSpec{
+			Java: v1alpha1.JavaSpec{
+				Image: "img:1",
+			},
+			Exporter: v1alpha1.Exporter{
+				Endpoint: "https://collector:4317",
+			},
+		},
+	}
+	insts := languageInstrumentations{
+		Java: &inst,
+	}
+	pod := inject(logr.Discard(), insts,
+		corev1.Namespace{},
+		corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "app",
+					},
+				},
+			},
+		})
+	assert.Equal(t, corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: volumeName,
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+			InitContainers: []corev1.Container{
+				{
+					Name:    initContainerName,
+					Image:   "img:1",
+					Command: []string{"cp", "/javaagent.jar", "/otel-auto-instrumentation/javaagent.jar"},
+					VolumeMounts: []corev1.VolumeMount{{
+						Name:      volumeName,
+						MountPath: "/otel-auto-instrumentation",
+					}},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      volumeName,
+							MountPath: "/otel-auto-instrumentation",
+						},
+					},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "OTEL_SERVICE_NAME",
+							Value: "app",
+						},
+						{
+							Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+							Value: "https://collector:4317",
+						},
+						{
+							Name:  "OTEL_RESOURCE_ATTRIBUTES",
+							Value: "k8s.container.name=app,k8s.namespace.name=",
+						},
+						{
+							Name:  "JAVA_TOOL_OPTIONS",
+							Value: javaJVMArgument,
+						},
+					},
+				},
+			},
+		},
+	}, pod)
+}
+
+func TestInjectJavaWithLogs(t *testing.T) {
+	inst := v1alpha1.Instrumentation{
+		Spec: v1alpha1.InstrumentationSpec{
+			Java: v1alpha1.JavaSpec{
+				Image: "img:1",
+			},
+			Exporter: v1alpha1.Exporter{
+				Endpoint: "https://collector:4317",
+			},
+			LogsExporter: "otlp",
+		},
+	}
+	insts := languageInstrumentations{
+		Java: &inst,
+	}
+	pod := inject(logr.Discard(), insts,
+		corev1.Namespace{},
+		corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "app",
+					},
+				},
+			},
+		})
+	assert.Equal(t, corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: volumeName,
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+			InitContainers: []corev1.Container{
+				{
+					Name:    initContainerName,
+					Image:   "img:1",
+					Command: []string{"cp", "/javaagent.jar", "/otel-auto-instrumentation/javaagent.jar"},
+					VolumeMounts: []corev1.VolumeMount{{
+						Name:      volumeName,
+						MountPath: "/otel-auto-instrumentation",
+					}},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      volumeName,
+							MountPath: "/otel-auto-instrumentation",
+						},
+					},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "OTEL_SERVICE_NAME",
+							Value: "app",
+						},
+						{
+							Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+							Value: "https://collector:4317",
+						},
+						{
+							Name:  "OTEL_RESOURCE_ATTRIBUTES",
+							Value: "k8s.container.name=app,k8s.namespace.name=",
+						},
+						{
+							Name:  "OTEL_LOGS_EXPORTER",
+							Value: "otlp",
+						},
+						{
+							Name:  "JAVA_TOOL_OPTIONS",
+							Value: javaJVMArgument,
+						},
+						{
+							Name:  "OTEL_INSTRUMENTATION_JAVA_LOGS_ENABLED",
+							Value: "true",
+						},
+					},
+				},
+			},
+		},
+	}, pod)
+}
+
+func TestInjectNodeJS(t *testing.T) {
 	inst := v1alpha1.Instrumentation{
 		Spec: v1alpha1.InstrumentationSpec{
-			Java: v1alpha1.JavaSpec{
+			NodeJS: v1alpha1.NodeJSSpec{
 				Image: "img:1",
 			},
 			Exporter: v1alpha1.Exporter{
-				Endpoint: "https://collector:4317",
+				Endpoint: "https://collector:4318",
 			},
 		},
 	}
 	insts := languageInstrumentations{
-		Java: &inst,
+		NodeJS: &inst,
 	}
 	pod := inject(logr.Discard(), insts,
 		corev1.Namespace{},
@@ -234,7 +734,7 @@ func TestInjectJava(t *testing.T) {
 				{
 					Name:    initContainerName,
 					Image:   "img:1",
-					Command: []string{"cp", "/javaagent.jar", "/otel-auto-instrumentation/javaagent.jar"},
+					Command: []string{"cp", "-a", "/autoinstrumentation/.", "/otel-auto-instrumentation/"},
 					VolumeMounts: []corev1.VolumeMount{{
 						Name:      volumeName,
 						MountPath: "/otel-auto-instrumentation",
@@ -257,15 +757,15 @@ func TestInjectJava(t *testing.T) {
 						},
 						{
 							Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
-							Value: "https://collector:4317",
+							Value: "https://collector:4318",
 						},
 						{
 							Name:  "OTEL_RESOURCE_ATTRIBUTES",
 							Value: "k8s.container.name=app,k8s.namespace.name=",
 						},
 						{
-							Name:  "JAVA_TOOL_OPTIONS",
-							Value: javaJVMArgument,
+							Name:  "NODE_OPTIONS",
+							Value: nodeRequireArgument,
 						},
 					},
 				},
@@ -274,7 +774,7 @@ func TestInjectJava(t *testing.T) {
 	}, pod)
 }
 
-func TestInjectNodeJS(t *testing.T) {
+func TestInjectNodeJSWithLogs(t *testing.T) {
 	inst := v1alpha1.Instrumentation{
 		Spec: v1alpha1.InstrumentationSpec{
 			NodeJS: v1alpha1.NodeJSSpec{
@@ -283,6 +783,7 @@ func TestInjectNodeJS(t *testing.T) {
 			Exporter: v1alpha1.Exporter{
 				Endpoint: "https://collector:4318",
 			},
+			LogsExporter: "otlp",
 		},
 	}
 	insts := languageInstrumentations{
@@ -342,13 +843,227 @@ func TestInjectNodeJS(t *testing.T) {
 							Name:  "OTEL_RESOURCE_ATTRIBUTES",
 							Value: "k8s.container.name=app,k8s.namespace.name=",
 						},
+						{
+							Name:  "OTEL_LOGS_EXPORTER",
+							Value: "otlp",
+						},
 						{
 							Name:  "NODE_OPTIONS",
 							Value: nodeRequireArgument,
 						},
+						{
+							Name:  "OTEL_NODE_ENABLE_EXPERIMENTAL_LOGS",
+							Value: "true",
+						},
+					},
+				},
+			},
+		},
+	}, pod)
+}
+
+func TestInjectPython(t *testing.T) {
+	inst := v1alpha1.Instrumentation{
+		Spec: v1alpha1.InstrumentationSpec{
+			Python: v1alpha1.PythonSpec{
+				Image: "img:1",
+			},
+			Exporter: v1alpha1.Exporter{
+				Endpoint: "https://collector:4317",
+			},
+		},
+	}
+	insts := languageInstrumentations{
+		Python: &inst,
+	}
+	pod := inject(logr.Discard(), insts,
+		corev1.Namespace{},
+		corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "app",
+					},
+				},
+			},
+		})
+	assert.Equal(t, corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: volumeName,
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+			InitContainers: []corev1.Container{
+				{
+					Name:    initContainerName,
+					Image:   "img:1",
+					Command: []string{"cp", "-a", "/autoinstrumentation/.", "/otel-auto-instrumentation/"},
+					VolumeMounts: []corev1.VolumeMount{{
+						Name:      volumeName,
+						MountPath: "/otel-auto-instrumentation",
+					}},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      volumeName,
+							MountPath: "/otel-auto-instrumentation",
+						},
+					},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "OTEL_SERVICE_NAME",
+							Value: "app",
+						},
+						{
+							Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+							Value: "https://collector:4317",
+						},
+						{
+							Name:  "OTEL_RESOURCE_ATTRIBUTES",
+							Value: "k8s.container.name=app,k8s.namespace.name=",
+						},
+						{
+							Name:  "PYTHONPATH",
+							Value: "/otel-auto-instrumentation",
+						},
+					},
+				},
+			},
+		},
+	}, pod)
+}
+
+func TestInjectDotNet(t *testing.T) {
+	inst := v1alpha1.Instrumentation{
+		Spec: v1alpha1.InstrumentationSpec{
+			DotNet: v1alpha1.DotNetSpec{
+				Image: "img:1",
+			},
+			Exporter: v1alpha1.Exporter{
+				Endpoint: "https://collector:4317",
+			},
+		},
+	}
+	insts := languageInstrumentations{
+		DotNet: &inst,
+	}
+	pod := inject(logr.Discard(), insts,
+		corev1.Namespace{},
+		corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "app",
+					},
+				},
+			},
+		})
+	assert.Equal(t, corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: volumeName,
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+			InitContainers: []corev1.Container{
+				{
+					Name:    initContainerName,
+					Image:   "img:1",
+					Command: []string{"cp", "-a", "/autoinstrumentation/.", "/otel-auto-instrumentation/"},
+					VolumeMounts: []corev1.VolumeMount{{
+						Name:      volumeName,
+						MountPath: "/otel-auto-instrumentation",
+					}},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      volumeName,
+							MountPath: "/otel-auto-instrumentation",
+						},
+					},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "OTEL_SERVICE_NAME",
+							Value: "app",
+						},
+						{
+							Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+							Value: "https://collector:4317",
+						},
+						{
+							Name:  "OTEL_RESOURCE_ATTRIBUTES",
+							Value: "k8s.container.name=app,k8s.namespace.name=",
+						},
+						{
+							Name:  "CORECLR_ENABLE_PROFILING",
+							Value: "1",
+						},
+						{
+							Name:  "CORECLR_PROFILER",
+							Value: "{918728DD-259F-4A6A-AC2B-B85E1B658318}",
+						},
+						{
+							Name:  "CORECLR_PROFILER_PATH",
+							Value: "/otel-auto-instrumentation/OpenTelemetry.AutoInstrumentation.Native.so",
+						},
+						{
+							Name:  "DOTNET_STARTUP_HOOKS",
+							Value: "/otel-auto-instrumentation/netcoreapp3.1/OpenTelemetry.AutoInstrumentation.StartupHook.dll",
+						},
 					},
 				},
 			},
 		},
 	}, pod)
 }
+
+func TestInjectDotNetWithExistingStartupHook(t *testing.T) {
+	inst := v1alpha1.Instrumentation{
+		Spec: v1alpha1.InstrumentationSpec{
+			DotNet: v1alpha1.DotNetSpec{
+				Image: "img:1",
+			},
+			Exporter: v1alpha1.Exporter{
+				Endpoint: "https://collector:4317",
+			},
+		},
+	}
+	insts := languageInstrumentations{
+		DotNet: &inst,
+	}
+	pod := inject(logr.Discard(), insts,
+		corev1.Namespace{},
+		corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "app",
+						Env: []corev1.EnvVar{
+							{
+								Name:  "DOTNET_STARTUP_HOOKS",
+								Value: "/app/MyStartupHook.dll",
+							},
+						},
+					},
+				},
+			},
+		})
+	container := pod.Spec.Containers[0]
+	idx := getIndexOfEnv(container.Env, "DOTNET_STARTUP_HOOKS")
+	assert.Equal(t, "/app/MyStartupHook.dll;/otel-auto-instrumentation/netcoreapp3.1/OpenTelemetry.AutoInstrumentation.StartupHook.dll", container.Env[idx].Value)
+}